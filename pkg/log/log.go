@@ -0,0 +1,104 @@
+// Package log provides a structured logger used throughout lazydocker.
+//
+// It wraps logrus so that call sites can log at a level (Debug/Info/Warn/Error)
+// without caring where the output ends up: in --debug mode we additionally tee
+// everything to a file inside the user's config dir, so a crash can be
+// diagnosed after the fact instead of only in the scrollback of a terminal
+// that's already gone.
+package log
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/go-errors/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Logger is the logger used across the app. Callers shouldn't reach for
+// logrus directly so that we have one place to control formatting, sinks and
+// the set of fields that get attached to every line.
+type Logger struct {
+	*logrus.Entry
+}
+
+// Fields is a shorthand for attaching structured context (panel name,
+// container ID, docker command, etc.) to a log line.
+type Fields = logrus.Fields
+
+// NewLogger creates a Logger. When debug is true the level is bumped to
+// Debug and a file sink is opened inside configDir (in addition to stderr);
+// when false only Info-and-above goes to stderr. humanReadable picks between
+// the TextFormatter (for reading in a terminal) and the JSONFormatter (for
+// machine consumption, e.g. `jq . development.log`).
+func NewLogger(configDir string, debug bool, humanReadable bool) (*Logger, error) {
+	baseLogger := logrus.New()
+
+	var formatter logrus.Formatter
+	if humanReadable {
+		formatter = &logrus.TextFormatter{FullTimestamp: true}
+	} else {
+		formatter = &logrus.JSONFormatter{}
+	}
+	baseLogger.SetFormatter(formatter)
+
+	level := logrus.InfoLevel
+	if debug {
+		level = logrus.DebugLevel
+	}
+	baseLogger.SetLevel(level)
+
+	var out io.Writer = os.Stderr
+	if debug {
+		file, err := openLogFile(configDir)
+		if err != nil {
+			return nil, err
+		}
+		out = io.MultiWriter(os.Stderr, file)
+	}
+	baseLogger.SetOutput(out)
+
+	return &Logger{Entry: logrus.NewEntry(baseLogger)}, nil
+}
+
+// openLogFile opens (creating if necessary) development.log inside
+// configDir, truncating any previous run's contents so each session starts
+// from a clean file.
+func openLogFile(configDir string) (*os.File, error) {
+	if err := os.MkdirAll(configDir, 0o755); err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	path := filepath.Join(configDir, "development.log")
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+	return file, nil
+}
+
+// WithField returns a Logger with an additional field attached to every
+// subsequent line, e.g. logger.WithField("panel", "containers").Info("...").
+func (l *Logger) WithField(key string, value interface{}) *Logger {
+	return &Logger{Entry: l.Entry.WithField(key, value)}
+}
+
+// WithFields is the plural form of WithField.
+func (l *Logger) WithFields(fields Fields) *Logger {
+	return &Logger{Entry: l.Entry.WithFields(fields)}
+}
+
+// LogPanic records err (wrapped in a *errors.Error so we get a stack trace
+// if it isn't one already) at Error level along with its stack frames, then
+// re-panics with the same value so the caller's recover/cleanup logic still
+// runs as before.
+func LogPanic(logger *Logger, recovered interface{}) {
+	err, ok := recovered.(error)
+	if !ok {
+		err = errors.Errorf("%v", recovered)
+	}
+	wrapped := errors.Wrap(err, 1)
+	logger.WithField("stack", wrapped.ErrorStack()).Error(wrapped.Error())
+	panic(recovered)
+}
@@ -0,0 +1,96 @@
+package log
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLoggerFormatter(t *testing.T) {
+	type scenario struct {
+		testName      string
+		humanReadable bool
+		test          func(logrus.Formatter)
+	}
+
+	scenarios := []scenario{
+		{
+			"human readable uses TextFormatter",
+			true,
+			func(formatter logrus.Formatter) {
+				_, ok := formatter.(*logrus.TextFormatter)
+				assert.True(t, ok)
+			},
+		},
+		{
+			"machine readable uses JSONFormatter",
+			false,
+			func(formatter logrus.Formatter) {
+				_, ok := formatter.(*logrus.JSONFormatter)
+				assert.True(t, ok)
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			logger, err := NewLogger(t.TempDir(), false, s.humanReadable)
+			assert.NoError(t, err)
+			s.test(logger.Logger.Formatter)
+		})
+	}
+}
+
+func TestNewLoggerFileSink(t *testing.T) {
+	t.Run("debug mode writes development.log", func(t *testing.T) {
+		configDir := t.TempDir()
+
+		logger, err := NewLogger(configDir, true, true)
+		assert.NoError(t, err)
+
+		logger.Info("hello from debug mode")
+
+		path := filepath.Join(configDir, "development.log")
+		content, err := os.ReadFile(path)
+		assert.NoError(t, err)
+		assert.Contains(t, string(content), "hello from debug mode")
+	})
+
+	t.Run("non-debug mode does not create development.log", func(t *testing.T) {
+		configDir := t.TempDir()
+
+		logger, err := NewLogger(configDir, false, true)
+		assert.NoError(t, err)
+
+		logger.Info("hello from non-debug mode")
+
+		path := filepath.Join(configDir, "development.log")
+		_, err = os.Stat(path)
+		assert.True(t, os.IsNotExist(err))
+	})
+}
+
+func TestLogPanic(t *testing.T) {
+	var buf bytes.Buffer
+	baseLogger := logrus.New()
+	baseLogger.SetOutput(&buf)
+	baseLogger.SetFormatter(&logrus.JSONFormatter{})
+	logger := &Logger{Entry: logrus.NewEntry(baseLogger)}
+
+	recovered := func() (recovered interface{}) {
+		defer func() {
+			recovered = recover()
+		}()
+		LogPanic(logger, "boom")
+		return nil
+	}()
+
+	assert.Equal(t, "boom", recovered)
+	assert.Contains(t, buf.String(), "boom")
+	assert.Contains(t, buf.String(), "stack")
+}
@@ -0,0 +1,28 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/fatih/color"
+)
+
+// ColoredString takes a string and one or more colour attributes and returns
+// a coloured string with those attributes applied, e.g.
+// ColoredString("uh oh", color.FgRed, color.Bold).
+func ColoredString(str string, colorAttributes ...color.Attribute) string {
+	colour := color.New(colorAttributes...)
+	return ColoredStringDirect(str, colour)
+}
+
+// ColoredStringDirect used for aggregating a few color attributes rather than
+// just sending a single one
+func ColoredStringDirect(str string, colour *color.Color) string {
+	return colour.SprintFunc()(fmt.Sprint(str))
+}
+
+// Decolorise strips a string of color
+func Decolorise(str string) string {
+	re := regexp.MustCompile(`\x1B\[([0-9]{1,2}(;[0-9]{1,2})?)?[m|K]`)
+	return re.ReplaceAllString(str, "")
+}
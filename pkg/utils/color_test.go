@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColoredString(t *testing.T) {
+	type scenario struct {
+		testName   string
+		str        string
+		attributes []color.Attribute
+		test       func(string)
+	}
+
+	scenarios := []scenario{
+		{
+			"single attribute",
+			"hello",
+			[]color.Attribute{color.FgRed},
+			func(output string) {
+				assert.Equal(t, "hello", Decolorise(output))
+			},
+		},
+		{
+			"multiple attributes composed in one call",
+			"hello",
+			[]color.Attribute{color.FgRed, color.Bold},
+			func(output string) {
+				assert.Equal(t, "hello", Decolorise(output))
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			s.test(ColoredString(s.str, s.attributes...))
+		})
+	}
+}
+
+func TestDecolorise(t *testing.T) {
+	type scenario struct {
+		testName string
+		str      string
+		expected string
+	}
+
+	scenarios := []scenario{
+		{
+			"plain string",
+			"hello",
+			"hello",
+		},
+		{
+			"coloured string",
+			ColoredString("hello", color.FgRed),
+			"hello",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			assert.Equal(t, s.expected, Decolorise(s.str))
+		})
+	}
+}
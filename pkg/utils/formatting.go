@@ -0,0 +1,423 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/mattn/go-runewidth"
+)
+
+// displayWidth measures how many terminal columns str will occupy once
+// colour codes are stripped, using rune width rather than byte/rune count so
+// that multi-byte characters (CJK, emoji) which render wider than a single
+// column don't throw off our padding.
+func displayWidth(str string) int {
+	return runewidth.StringWidth(Decolorise(str))
+}
+
+// WithPadding pads a string as much as you want
+func WithPadding(str string, padding int) string {
+	width := displayWidth(str)
+	if padding < width {
+		return str
+	}
+	return str + strings.Repeat(" ", padding-width)
+}
+
+// withPaddingAligned pads str out to padding, either by appending spaces
+// (AlignLeft) or by prepending them (AlignRight/AlignNumeric).
+func withPaddingAligned(str string, padding int, alignment ColumnAlignment) string {
+	if alignment == AlignLeft {
+		return WithPadding(str, padding)
+	}
+
+	width := displayWidth(str)
+	if padding < width {
+		return str
+	}
+	return strings.Repeat(" ", padding-width) + str
+}
+
+// Truncate shortens str to at most width display columns, respecting ANSI
+// colour escapes (they're passed through untouched and don't count towards
+// width, and we never truncate inside one). If ellipsis is true and str had
+// to be cut short, a trailing "…" is appended within the given width.
+func Truncate(str string, width int, ellipsis bool) string {
+	if width <= 0 {
+		return ""
+	}
+	if displayWidth(str) <= width {
+		return str
+	}
+
+	targetWidth := width
+	if ellipsis {
+		targetWidth--
+	}
+
+	var b strings.Builder
+	currentWidth := 0
+	runes := []rune(str)
+	for i := 0; i < len(runes); {
+		if runes[i] == '\x1B' {
+			seqEnd := i + 1
+			if seqEnd < len(runes) && runes[seqEnd] == '[' {
+				seqEnd++
+				for seqEnd < len(runes) && runes[seqEnd] != 'm' && runes[seqEnd] != 'K' {
+					seqEnd++
+				}
+				if seqEnd < len(runes) {
+					seqEnd++
+				}
+			}
+			b.WriteString(string(runes[i:seqEnd]))
+			i = seqEnd
+			continue
+		}
+
+		w := runewidth.RuneWidth(runes[i])
+		if currentWidth+w > targetWidth {
+			break
+		}
+		b.WriteRune(runes[i])
+		currentWidth += w
+		i++
+	}
+
+	if ellipsis {
+		b.WriteRune('…')
+	}
+	return b.String()
+}
+
+// ColumnAlignment describes how a column's cells should be padded when
+// rendered by RenderList.
+type ColumnAlignment int
+
+const (
+	// AlignLeft pads on the right so text starts flush with the column (default)
+	AlignLeft ColumnAlignment = iota
+	// AlignRight pads on the left so text ends flush with the column
+	AlignRight
+	// AlignNumeric is an alias of AlignRight for use with numeric columns
+	// such as CPU% or MEM, kept distinct so call sites read as intent rather
+	// than layout
+	AlignNumeric
+)
+
+type Displayable interface {
+	GetDisplayStrings(bool) []string
+}
+
+type RenderListConfig struct {
+	IsFocused bool
+	Header    []string
+	Alignment []ColumnAlignment
+	MaxWidths []int
+}
+
+func IsFocused(isFocused bool) func(c *RenderListConfig) {
+	return func(c *RenderListConfig) {
+		c.IsFocused = isFocused
+	}
+}
+
+func WithHeader(header []string) func(c *RenderListConfig) {
+	return func(c *RenderListConfig) {
+		c.Header = header
+	}
+}
+
+// WithColumnAlignment sets the alignment to use per-column. Columns beyond
+// the end of the slice default to AlignLeft. The last column is never padded,
+// so the alignment given for it has no effect.
+func WithColumnAlignment(alignment ...ColumnAlignment) func(c *RenderListConfig) {
+	return func(c *RenderListConfig) {
+		c.Alignment = alignment
+	}
+}
+
+// WithMaxWidths caps each column at the given number of display columns,
+// truncating with an ellipsis instead of letting a long value (e.g. a
+// container name) wrap and break the panel layout. A zero for a column
+// means "no limit"; columns beyond the end of the slice are also unlimited.
+func WithMaxWidths(maxWidths ...int) func(c *RenderListConfig) {
+	return func(c *RenderListConfig) {
+		c.MaxWidths = maxWidths
+	}
+}
+
+// RenderList takes a slice of items, confirms they implement the Displayable
+// interface, then generates a list of their displaystrings to write to a panel's
+// buffer
+func RenderList(slice interface{}, options ...func(*RenderListConfig)) (string, error) {
+	config := &RenderListConfig{}
+	for _, option := range options {
+		option(config)
+	}
+
+	s := reflect.ValueOf(slice)
+	if s.Kind() != reflect.Slice {
+		return "", errors.New("RenderList given a non-slice type")
+	}
+
+	displayables := make([]Displayable, s.Len())
+
+	for i := 0; i < s.Len(); i++ {
+		value, ok := s.Index(i).Interface().(Displayable)
+		if !ok {
+			return "", errors.New("item does not implement the Displayable interface")
+		}
+		displayables[i] = value
+	}
+
+	return renderDisplayableList(displayables, *config)
+}
+
+// renderDisplayableList takes a list of displayable items, obtains their display
+// strings via GetDisplayStrings() and then returns a single string containing
+// each item's string representation on its own line, with appropriate horizontal
+// padding between the item's own strings
+func renderDisplayableList(items []Displayable, config RenderListConfig) (string, error) {
+	if len(items) == 0 {
+		return "", nil
+	}
+
+	stringArrays := getDisplayStringArrays(items, config.IsFocused)
+	if len(config.Header) > 0 {
+		stringArrays = append([][]string{config.Header}, stringArrays...)
+	}
+
+	if !displayArraysAligned(stringArrays) {
+		return "", errors.New("Each item must return the same number of strings to display")
+	}
+
+	stringArrays = applyMaxWidths(stringArrays, config.MaxWidths)
+	padWidths := getPadWidths(stringArrays)
+	paddedDisplayStrings := getPaddedDisplayStrings(stringArrays, padWidths, config.Alignment)
+
+	return strings.Join(paddedDisplayStrings, "\n"), nil
+}
+
+// applyMaxWidths truncates each cell to its column's configured max width
+// (if any), leaving stringArrays untouched when no limits were set.
+func applyMaxWidths(stringArrays [][]string, maxWidths []int) [][]string {
+	if len(maxWidths) == 0 {
+		return stringArrays
+	}
+
+	result := make([][]string, len(stringArrays))
+	for i, row := range stringArrays {
+		newRow := make([]string, len(row))
+		for j, cell := range row {
+			if j < len(maxWidths) && maxWidths[j] > 0 {
+				newRow[j] = Truncate(cell, maxWidths[j], true)
+			} else {
+				newRow[j] = cell
+			}
+		}
+		result[i] = newRow
+	}
+	return result
+}
+
+func getPadWidths(stringArrays [][]string) []int {
+	if len(stringArrays[0]) <= 1 {
+		return []int{}
+	}
+	padWidths := make([]int, len(stringArrays[0])-1)
+	for i := range padWidths {
+		for _, strings := range stringArrays {
+			width := displayWidth(strings[i])
+			if width > padWidths[i] {
+				padWidths[i] = width
+			}
+		}
+	}
+	return padWidths
+}
+
+// columnAlignment returns the alignment configured for column i, defaulting
+// to AlignLeft when the caller didn't specify one.
+func columnAlignment(alignments []ColumnAlignment, i int) ColumnAlignment {
+	if i >= len(alignments) {
+		return AlignLeft
+	}
+	return alignments[i]
+}
+
+func getPaddedDisplayStrings(stringArrays [][]string, padWidths []int, alignments []ColumnAlignment) []string {
+	paddedDisplayStrings := make([]string, len(stringArrays))
+	for i, stringArray := range stringArrays {
+		if len(stringArray) == 0 {
+			continue
+		}
+		for j, padWidth := range padWidths {
+			paddedDisplayStrings[i] += withPaddingAligned(stringArray[j], padWidth, columnAlignment(alignments, j)) + " "
+		}
+		paddedDisplayStrings[i] += stringArray[len(padWidths)]
+	}
+	return paddedDisplayStrings
+}
+
+// displayArraysAligned returns true if every string array returned from our
+// list of displayables has the same length
+func displayArraysAligned(stringArrays [][]string) bool {
+	for _, strings := range stringArrays {
+		if len(strings) != len(stringArrays[0]) {
+			return false
+		}
+	}
+	return true
+}
+
+func getDisplayStringArrays(displayables []Displayable, isFocused bool) [][]string {
+	stringArrays := make([][]string, len(displayables))
+	for i, item := range displayables {
+		stringArrays[i] = item.GetDisplayStrings(isFocused)
+	}
+	return stringArrays
+}
+
+func FormatBinaryBytes(b int) string {
+	n := float64(b)
+	units := []string{"B", "kiB", "MiB", "GiB", "TiB", "PiB", "EiB", "ZiB", "YiB"}
+	for _, unit := range units {
+		if n > math.Pow(2, 10) {
+			n = n / math.Pow(2, 10)
+		} else {
+			val := fmt.Sprintf("%.2f%s", n, unit)
+			if val == "0.00B" {
+				return "0B"
+			}
+			return val
+		}
+	}
+	return "a lot"
+}
+
+func FormatDecimalBytes(b int) string {
+	n := float64(b)
+	units := []string{"B", "kB", "MB", "GB", "TB", "PB", "EB", "ZB", "YB"}
+	for _, unit := range units {
+		if n > math.Pow(10, 3) {
+			n = n / math.Pow(10, 3)
+		} else {
+			val := fmt.Sprintf("%.2f%s", n, unit)
+			if val == "0.00B" {
+				return "0B"
+			}
+			return val
+		}
+	}
+	return "a lot"
+}
+
+// durationUnits breaks d down into "3d", "4h", "13m", "45s"-style segments,
+// largest unit first, omitting any unit that's zero except seconds when
+// nothing bigger applies (so we never return an empty slice).
+func durationUnits(d time.Duration) []string {
+	if d < 0 {
+		d = -d
+	}
+
+	days := int(d / (24 * time.Hour))
+	d -= time.Duration(days) * 24 * time.Hour
+	hours := int(d / time.Hour)
+	d -= time.Duration(hours) * time.Hour
+	minutes := int(d / time.Minute)
+	d -= time.Duration(minutes) * time.Minute
+	seconds := int(d / time.Second)
+
+	segments := []string{}
+	if days > 0 {
+		segments = append(segments, fmt.Sprintf("%dd", days))
+	}
+	if hours > 0 {
+		segments = append(segments, fmt.Sprintf("%dh", hours))
+	}
+	if minutes > 0 {
+		segments = append(segments, fmt.Sprintf("%dm", minutes))
+	}
+	if seconds > 0 || len(segments) == 0 {
+		segments = append(segments, fmt.Sprintf("%ds", seconds))
+	}
+	return segments
+}
+
+// FormatDuration renders d as a compact human string such as "2h13m",
+// "3d4h" or "45s". Durations under a second are rendered in milliseconds
+// (e.g. "500ms") since "0s" would otherwise hide that anything happened at
+// all.
+func FormatDuration(d time.Duration) string {
+	if d < 0 {
+		d = -d
+	}
+	if d < time.Second {
+		return fmt.Sprintf("%dms", d.Milliseconds())
+	}
+	return strings.Join(durationUnits(d), "")
+}
+
+// FormatDurationShort is FormatDuration capped at its two largest units, for
+// narrow panels where "3d4h13m45s" wouldn't fit.
+func FormatDurationShort(d time.Duration) string {
+	if d > -time.Second && d < time.Second {
+		return FormatDuration(d)
+	}
+	segments := durationUnits(d)
+	if len(segments) > 2 {
+		segments = segments[:2]
+	}
+	return strings.Join(segments, "")
+}
+
+// relativeUnit returns the largest whole unit that fits d, along with how
+// many of that unit have elapsed, e.g. 90*time.Second -> ("minute", 1).
+func relativeUnit(d time.Duration) (string, int) {
+	seconds := int(d.Seconds())
+	switch {
+	case seconds < 60:
+		return "second", seconds
+	case seconds < 60*60:
+		return "minute", seconds / 60
+	case seconds < 60*60*24:
+		return "hour", seconds / (60 * 60)
+	case seconds < 60*60*24*30:
+		return "day", seconds / (60 * 60 * 24)
+	case seconds < 60*60*24*365:
+		return "month", seconds / (60 * 60 * 24 * 30)
+	default:
+		return "year", seconds / (60 * 60 * 24 * 365)
+	}
+}
+
+// FormatRelativeTime renders t relative to now as "5 minutes ago" or,
+// for a time in the future, "in 5 minutes" - useful for container/image
+// CreatedAt and StartedAt fields that would otherwise show as raw RFC3339.
+func FormatRelativeTime(t time.Time) string {
+	return formatRelativeTime(t, time.Now())
+}
+
+func formatRelativeTime(t time.Time, now time.Time) string {
+	d := now.Sub(t)
+	future := d < 0
+	if future {
+		d = -d
+	}
+
+	unit, quantity := relativeUnit(d)
+	plural := ""
+	if quantity != 1 {
+		plural = "s"
+	}
+
+	if future {
+		return fmt.Sprintf("in %d %s%s", quantity, unit, plural)
+	}
+	return fmt.Sprintf("%d %s%s ago", quantity, unit, plural)
+}
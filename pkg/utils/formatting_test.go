@@ -0,0 +1,286 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithPadding(t *testing.T) {
+	type scenario struct {
+		testName string
+		str      string
+		padding  int
+		expected string
+	}
+
+	scenarios := []scenario{
+		{
+			"padding shorter than string",
+			"hello",
+			2,
+			"hello",
+		},
+		{
+			"padding longer than string",
+			"hello",
+			8,
+			"hello   ",
+		},
+		{
+			"ignores colour codes when measuring width",
+			ColoredString("hi", color.FgRed),
+			5,
+			ColoredString("hi", color.FgRed) + "   ",
+		},
+		{
+			"wide runes count for two columns",
+			"你好",
+			6,
+			"你好  ",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			assert.Equal(t, s.expected, WithPadding(s.str, s.padding))
+		})
+	}
+}
+
+func TestFormatBinaryBytes(t *testing.T) {
+	type scenario struct {
+		testName string
+		input    int
+		expected string
+	}
+
+	scenarios := []scenario{
+		{"zero", 0, "0B"},
+		{"bytes", 512, "512.00B"},
+		{"kibibytes", 2048, "2.00kiB"},
+		{"mebibytes", 5 * 1024 * 1024, "5.00MiB"},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			assert.Equal(t, s.expected, FormatBinaryBytes(s.input))
+		})
+	}
+}
+
+func TestFormatDecimalBytes(t *testing.T) {
+	type scenario struct {
+		testName string
+		input    int
+		expected string
+	}
+
+	scenarios := []scenario{
+		{"zero", 0, "0B"},
+		{"bytes", 512, "512.00B"},
+		{"kilobytes", 2000, "2.00kB"},
+		{"megabytes", 5000000, "5.00MB"},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			assert.Equal(t, s.expected, FormatDecimalBytes(s.input))
+		})
+	}
+}
+
+type testDisplayable struct {
+	strings []string
+}
+
+func (d *testDisplayable) GetDisplayStrings(isFocused bool) []string {
+	return d.strings
+}
+
+func TestRenderList(t *testing.T) {
+	type scenario struct {
+		testName string
+		items    []*testDisplayable
+		options  []func(*RenderListConfig)
+		expected string
+	}
+
+	scenarios := []scenario{
+		{
+			"no items",
+			[]*testDisplayable{},
+			nil,
+			"",
+		},
+		{
+			"left-aligned columns by default",
+			[]*testDisplayable{
+				{[]string{"a", "bb", "1"}},
+				{[]string{"ccc", "d", "22"}},
+			},
+			nil,
+			"a   bb 1\nccc d  22",
+		},
+		{
+			"right-aligned numeric column",
+			[]*testDisplayable{
+				{[]string{"a", "1", "x"}},
+				{[]string{"bb", "22", "y"}},
+			},
+			[]func(*RenderListConfig){WithColumnAlignment(AlignLeft, AlignNumeric)},
+			"a   1 x\nbb 22 y",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			output, err := RenderList(s.items, s.options...)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, output)
+		})
+	}
+}
+
+func TestRenderListMaxWidths(t *testing.T) {
+	items := []*testDisplayable{
+		{[]string{"my-really-long-container-name", "running"}},
+	}
+
+	output, err := RenderList(items, WithMaxWidths(10, 0))
+	assert.NoError(t, err)
+	assert.Equal(t, "my-really…"+" running", output)
+}
+
+func TestTruncate(t *testing.T) {
+	type scenario struct {
+		testName string
+		str      string
+		width    int
+		ellipsis bool
+		expected string
+	}
+
+	scenarios := []scenario{
+		{
+			"shorter than width is unchanged",
+			"hi",
+			5,
+			true,
+			"hi",
+		},
+		{
+			"truncates with ellipsis",
+			"hello world",
+			6,
+			true,
+			"hello…",
+		},
+		{
+			"truncates without ellipsis",
+			"hello world",
+			5,
+			false,
+			"hello",
+		},
+		{
+			"does not count ANSI escapes toward width",
+			ColoredString("hello", color.FgRed) + " world",
+			6,
+			true,
+			ColoredString("hello", color.FgRed) + "…",
+		},
+		{
+			"wide runes are truncated by column width, not rune count",
+			"你好世界",
+			5,
+			true,
+			"你好…",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			assert.Equal(t, s.expected, Truncate(s.str, s.width, s.ellipsis))
+		})
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	type scenario struct {
+		testName string
+		duration time.Duration
+		expected string
+	}
+
+	scenarios := []scenario{
+		{"sub-second", 500 * time.Millisecond, "500ms"},
+		{"seconds only", 45 * time.Second, "45s"},
+		{"minutes and seconds", 2*time.Minute + 13*time.Second, "2m13s"},
+		{"hours and minutes", 2*time.Hour + 13*time.Minute, "2h13m"},
+		{"days and hours", 3*24*time.Hour + 4*time.Hour, "3d4h"},
+		{"negative treated as magnitude", -45 * time.Second, "45s"},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			assert.Equal(t, s.expected, FormatDuration(s.duration))
+		})
+	}
+}
+
+func TestFormatDurationShort(t *testing.T) {
+	type scenario struct {
+		testName string
+		duration time.Duration
+		expected string
+	}
+
+	scenarios := []scenario{
+		{"caps at two units", 3*24*time.Hour + 4*time.Hour + 13*time.Minute, "3d4h"},
+		{"fewer than two units unchanged", 45 * time.Second, "45s"},
+		{"sub-second unchanged", 500 * time.Millisecond, "500ms"},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			assert.Equal(t, s.expected, FormatDurationShort(s.duration))
+		})
+	}
+}
+
+func TestFormatRelativeTime(t *testing.T) {
+	now := time.Date(2020, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	type scenario struct {
+		testName string
+		t        time.Time
+		expected string
+	}
+
+	scenarios := []scenario{
+		{"seconds ago", now.Add(-5 * time.Second), "5 seconds ago"},
+		{"one minute ago is singular", now.Add(-1 * time.Minute), "1 minute ago"},
+		{"minutes ago", now.Add(-5 * time.Minute), "5 minutes ago"},
+		{"hours ago", now.Add(-3 * time.Hour), "3 hours ago"},
+		{"days ago", now.Add(-2 * 24 * time.Hour), "2 days ago"},
+		{"over a year ago", now.Add(-400 * 24 * time.Hour), "1 year ago"},
+		{"in the future", now.Add(5 * time.Minute), "in 5 minutes"},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			assert.Equal(t, s.expected, formatRelativeTime(s.t, now))
+		})
+	}
+}
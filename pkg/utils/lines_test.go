@@ -0,0 +1,86 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitLines(t *testing.T) {
+	type scenario struct {
+		testName string
+		str      string
+		expected []string
+	}
+
+	scenarios := []scenario{
+		{
+			"empty string",
+			"",
+			[]string{},
+		},
+		{
+			"single newline",
+			"\n",
+			[]string{},
+		},
+		{
+			"multiple lines",
+			"one\ntwo\nthree\n",
+			[]string{"one", "two", "three"},
+		},
+		{
+			"strips carriage returns",
+			"one\r\ntwo\r\n",
+			[]string{"one", "two"},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			assert.EqualValues(t, s.expected, SplitLines(s.str))
+		})
+	}
+}
+
+func TestTrimTrailingNewline(t *testing.T) {
+	type scenario struct {
+		testName string
+		str      string
+		expected string
+	}
+
+	scenarios := []scenario{
+		{"has trailing newline", "hello\n", "hello"},
+		{"no trailing newline", "hello", "hello"},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			assert.Equal(t, s.expected, TrimTrailingNewline(s.str))
+		})
+	}
+}
+
+func TestNormalizeLinefeeds(t *testing.T) {
+	type scenario struct {
+		testName string
+		str      string
+		expected string
+	}
+
+	scenarios := []scenario{
+		{"windows linefeeds", "one\r\ntwo", "one\ntwo"},
+		{"mac linefeeds", "one\rtwo", "onetwo"},
+		{"unix linefeeds unchanged", "one\ntwo", "one\ntwo"},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			assert.Equal(t, s.expected, NormalizeLinefeeds(s.str))
+		})
+	}
+}
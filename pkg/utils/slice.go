@@ -0,0 +1,75 @@
+package utils
+
+// IncludesString if the list contains the string
+func IncludesString(list []string, a string) bool {
+	for _, b := range list {
+		if b == a {
+			return true
+		}
+	}
+	return false
+}
+
+// NextIndex returns the index of the element that comes after the given number
+func NextIndex(numbers []int, currentNumber int) int {
+	for index, number := range numbers {
+		if number > currentNumber {
+			return index
+		}
+	}
+	return 0
+}
+
+// PrevIndex returns the index that comes before the given number, cycling if we reach the end
+func PrevIndex(numbers []int, currentNumber int) int {
+	end := len(numbers) - 1
+	for i := end; i >= 0; i -= 1 {
+		if numbers[i] < currentNumber {
+			return i
+		}
+	}
+	return end
+}
+
+// Filter returns a new slice containing only the elements of slice for which
+// predicate returns true.
+func Filter[T any](slice []T, predicate func(T) bool) []T {
+	result := make([]T, 0, len(slice))
+	for _, item := range slice {
+		if predicate(item) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// Map returns a new slice containing the result of applying fn to each
+// element of slice.
+func Map[T any, R any](slice []T, fn func(T) R) []R {
+	result := make([]R, len(slice))
+	for i, item := range slice {
+		result[i] = fn(item)
+	}
+	return result
+}
+
+// Find returns the first element of slice for which predicate returns true,
+// and whether such an element was found.
+func Find[T any](slice []T, predicate func(T) bool) (T, bool) {
+	for _, item := range slice {
+		if predicate(item) {
+			return item, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// Reverse returns a new slice with the elements of slice in reverse order.
+func Reverse[T any](slice []T) []T {
+	result := make([]T, len(slice))
+	for i, item := range slice {
+		result[len(slice)-1-i] = item
+	}
+	return result
+}
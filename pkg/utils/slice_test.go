@@ -0,0 +1,98 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIncludesString(t *testing.T) {
+	type scenario struct {
+		testName string
+		list     []string
+		str      string
+		expected bool
+	}
+
+	scenarios := []scenario{
+		{"present", []string{"a", "b"}, "b", true},
+		{"absent", []string{"a", "b"}, "c", false},
+		{"empty list", []string{}, "a", false},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			assert.Equal(t, s.expected, IncludesString(s.list, s.str))
+		})
+	}
+}
+
+func TestNextIndex(t *testing.T) {
+	type scenario struct {
+		testName      string
+		numbers       []int
+		currentNumber int
+		expected      int
+	}
+
+	scenarios := []scenario{
+		{"middle", []int{1, 3, 5}, 3, 2},
+		{"wraps to zero when nothing bigger", []int{1, 3, 5}, 5, 0},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			assert.Equal(t, s.expected, NextIndex(s.numbers, s.currentNumber))
+		})
+	}
+}
+
+func TestPrevIndex(t *testing.T) {
+	type scenario struct {
+		testName      string
+		numbers       []int
+		currentNumber int
+		expected      int
+	}
+
+	scenarios := []scenario{
+		{"middle", []int{1, 3, 5}, 3, 0},
+		{"cycles to end when nothing smaller", []int{1, 3, 5}, 1, 2},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			assert.Equal(t, s.expected, PrevIndex(s.numbers, s.currentNumber))
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	isEven := func(n int) bool { return n%2 == 0 }
+	assert.Equal(t, []int{2, 4}, Filter([]int{1, 2, 3, 4}, isEven))
+	assert.Equal(t, []int{}, Filter([]int{1, 3}, isEven))
+}
+
+func TestMap(t *testing.T) {
+	double := func(n int) int { return n * 2 }
+	assert.Equal(t, []int{2, 4, 6}, Map([]int{1, 2, 3}, double))
+}
+
+func TestFind(t *testing.T) {
+	isEven := func(n int) bool { return n%2 == 0 }
+
+	value, ok := Find([]int{1, 3, 4, 5}, isEven)
+	assert.True(t, ok)
+	assert.Equal(t, 4, value)
+
+	_, ok = Find([]int{1, 3, 5}, isEven)
+	assert.False(t, ok)
+}
+
+func TestReverse(t *testing.T) {
+	assert.Equal(t, []int{3, 2, 1}, Reverse([]int{1, 2, 3}))
+	assert.Equal(t, []int{}, Reverse([]int{}))
+}
@@ -0,0 +1,79 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/go-errors/errors"
+)
+
+// ResolvePlaceholderString populates a template with values
+func ResolvePlaceholderString(str string, arguments map[string]string) string {
+	for key, value := range arguments {
+		str = strings.Replace(str, "{{"+key+"}}", value, -1)
+	}
+	return str
+}
+
+// templateFuncs is the function map available to every template rendered
+// through ApplyTemplate, e.g. `{{.ID | quote}}` or
+// `{{.Shell | default "sh"}}`. Kept small and sprig-flavoured on purpose -
+// add to it as command templates need more.
+func templateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"upper": strings.ToUpper,
+		"lower": strings.ToLower,
+		"trim":  strings.TrimSpace,
+		"quote": func(s string) string {
+			return fmt.Sprintf("%q", s)
+		},
+		"default": func(fallback string, value string) string {
+			if value == "" {
+				return fallback
+			}
+			return value
+		},
+		"replace": func(old, new, s string) string {
+			return strings.ReplaceAll(s, old, new)
+		},
+		"join": func(sep string, list []string) string {
+			return strings.Join(list, sep)
+		},
+		"split": func(sep, s string) []string {
+			return strings.Split(s, sep)
+		},
+	}
+}
+
+// ApplyTemplate parses str as a Go template - with upper/lower/trim/quote/
+// default/replace/join/split available as pipeline functions - and executes
+// it against obj. Unlike a bare text/template call, parse and execute errors
+// are returned to the caller instead of panicking, so a typo in a user's
+// custom commandTemplates entry surfaces in the UI instead of silently
+// producing partial output.
+func ApplyTemplate(str string, obj interface{}) (string, error) {
+	tmpl, err := template.New("").Funcs(templateFuncs()).Parse(str)
+	if err != nil {
+		return "", errors.Wrap(err, 0)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, obj); err != nil {
+		return "", errors.Wrap(err, 0)
+	}
+	return buf.String(), nil
+}
+
+// MustApplyTemplate is ApplyTemplate for call sites using a template we
+// control ourselves and that genuinely can't fail - it panics on error
+// rather than threading one through call sites with no useful way to handle
+// it.
+func MustApplyTemplate(str string, obj interface{}) string {
+	output, err := ApplyTemplate(str, obj)
+	if err != nil {
+		panic(err)
+	}
+	return output
+}
@@ -0,0 +1,106 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolvePlaceholderString(t *testing.T) {
+	type scenario struct {
+		testName  string
+		str       string
+		arguments map[string]string
+		expected  string
+	}
+
+	scenarios := []scenario{
+		{
+			"replaces a single placeholder",
+			"docker logs {{id}}",
+			map[string]string{"id": "abc123"},
+			"docker logs abc123",
+		},
+		{
+			"no matching placeholders",
+			"docker ps",
+			map[string]string{"id": "abc123"},
+			"docker ps",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			assert.Equal(t, s.expected, ResolvePlaceholderString(s.str, s.arguments))
+		})
+	}
+}
+
+func TestApplyTemplate(t *testing.T) {
+	type scenario struct {
+		testName string
+		str      string
+		obj      interface{}
+		expected string
+	}
+
+	scenarios := []scenario{
+		{
+			"simple field access",
+			"hello {{.Name}}",
+			struct{ Name string }{Name: "world"},
+			"hello world",
+		},
+		{
+			"quote function",
+			"docker exec -it {{.ID | quote}}",
+			struct{ ID string }{ID: "abc 123"},
+			`docker exec -it "abc 123"`,
+		},
+		{
+			"default function falls back on empty value",
+			`{{.Shell | default "sh"}}`,
+			struct{ Shell string }{Shell: ""},
+			"sh",
+		},
+		{
+			"default function keeps non-empty value",
+			`{{.Shell | default "sh"}}`,
+			struct{ Shell string }{Shell: "bash"},
+			"bash",
+		},
+		{
+			"upper, lower, trim, replace, join, split",
+			`{{.Name | upper}} {{.Name | lower}} [{{.Padded | trim}}] {{.Name | replace "world" "there"}} {{.List | join ","}} {{index (.Csv | split ",") 1}}`,
+			struct {
+				Name   string
+				Padded string
+				List   []string
+				Csv    string
+			}{Name: "world", Padded: "  hi  ", List: []string{"a", "b"}, Csv: "x,y,z"},
+			"WORLD world [hi] there a,b y",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.testName, func(t *testing.T) {
+			output, err := ApplyTemplate(s.str, s.obj)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, output)
+		})
+	}
+}
+
+func TestApplyTemplateError(t *testing.T) {
+	_, err := ApplyTemplate("{{.Name | totallyNotAFunction}}", struct{ Name string }{Name: "world"})
+	assert.Error(t, err)
+}
+
+func TestMustApplyTemplate(t *testing.T) {
+	assert.Equal(t, "hello world", MustApplyTemplate("hello {{.Name}}", struct{ Name string }{Name: "world"}))
+	assert.Panics(t, func() {
+		MustApplyTemplate("{{.Name | totallyNotAFunction}}", struct{ Name string }{Name: "world"})
+	})
+}